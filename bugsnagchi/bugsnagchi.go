@@ -0,0 +1,44 @@
+package bugsnagchi
+
+import (
+	"net/http"
+
+	"github.com/bugsnag/bugsnag-go"
+)
+
+const FrameworkName string = "Chi"
+
+// AutoNotify sends any panics to bugsnag, and then re-raises them so Chi's
+// own recovery middleware (or the default process crash) still runs. Register
+// it after chi's middleware.Recoverer, so that it runs inside Recoverer and
+// Bugsnag sees the panic first. The arguments can be any RawData to pass to Bugsnag, most
+// usually you'll pass a bugsnag.Configuration object.
+func AutoNotify(rawData ...interface{}) func(http.Handler) http.Handler {
+	// Configure bugsnag with the passed in configuration (for manual notifications)
+	for _, datum := range rawData {
+		if c, ok := datum.(bugsnag.Configuration); ok {
+			bugsnag.Configure(c)
+		}
+	}
+
+	state := bugsnag.HandledState{
+		SeverityReason:   bugsnag.SeverityReasonUnhandledMiddlewareError,
+		OriginalSeverity: bugsnag.SeverityError,
+		Unhandled:        true,
+		Framework:        FrameworkName,
+	}
+	rawData = append(rawData, state)
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := bugsnag.StartSession(r.Context())
+			ctx = bugsnag.AttachRequestData(ctx, r)
+			r = r.WithContext(ctx)
+
+			// create a notifier that has the current request bound to it
+			notifier := bugsnag.New(append(rawData, r)...)
+			notifier.FlushSessionsOnRepanic(false)
+			defer notifier.AutoNotify(ctx, r)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package bugsnagecho
+
+import (
+	"github.com/bugsnag/bugsnag-go"
+	"github.com/labstack/echo/v4"
+)
+
+const FrameworkName string = "Echo"
+
+// AutoNotify sends any panics to bugsnag, and then re-raises them.
+// You should register this after echo-middleware/Recover() in the chain
+// so that Bugsnag sees the panic before Echo's own recovery does.
+// The arguments can be any RawData to pass to Bugsnag, most usually
+// you'll pass a bugsnag.Configuration object.
+func AutoNotify(rawData ...interface{}) echo.MiddlewareFunc {
+	// Configure bugsnag with the passed in configuration (for manual notifications)
+	for _, datum := range rawData {
+		if c, ok := datum.(bugsnag.Configuration); ok {
+			bugsnag.Configure(c)
+		}
+	}
+
+	state := bugsnag.HandledState{
+		SeverityReason:   bugsnag.SeverityReasonUnhandledMiddlewareError,
+		OriginalSeverity: bugsnag.SeverityError,
+		Unhandled:        true,
+		Framework:        FrameworkName,
+	}
+	rawData = append(rawData, state)
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			r := c.Request()
+			ctx := bugsnag.StartSession(r.Context())
+			ctx = bugsnag.AttachRequestData(ctx, r)
+			c.SetRequest(r.WithContext(ctx))
+
+			// create a notifier that has the current request bound to it
+			notifier := bugsnag.New(append(rawData, r)...)
+			notifier.FlushSessionsOnRepanic(false)
+			defer notifier.AutoNotify(ctx, r)
+			return next(c)
+		}
+	}
+}
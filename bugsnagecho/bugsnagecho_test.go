@@ -0,0 +1,108 @@
+package bugsnagecho
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/bitly/go-simplejson"
+	"github.com/bugsnag/bugsnag-go"
+	"github.com/labstack/echo/v4"
+)
+
+var testAPIKey = "166f5ad3590596f9aa8d601ea89af845"
+
+// setup starts a test event server for receiving the payload published by
+// AutoNotify, mirroring bugsnag_test.go's setup helper.
+func setup() (*httptest.Server, chan []byte) {
+	reports := make(chan []byte, 10)
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		reports <- body
+	})), reports
+}
+
+func generateSampleConfig(endpoint string) bugsnag.Configuration {
+	return bugsnag.Configuration{
+		APIKey:          testAPIKey,
+		Endpoints:       bugsnag.Endpoints{Notify: endpoint},
+		ProjectPackages: []string{"github.com/bugsnag/bugsnag-go/bugsnagecho"},
+		ReleaseStage:    "test",
+	}
+}
+
+func TestAutoNotify(t *testing.T) {
+	tt := []struct {
+		name   string
+		path   string
+		method string
+	}{
+		{name: "panic on GET", path: "/ok", method: http.MethodGet},
+		{name: "panic on POST", path: "/submit", method: http.MethodPost},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ts, reports := setup()
+			defer ts.Close()
+
+			e := echo.New()
+			e.Use(AutoNotify(generateSampleConfig(ts.URL)))
+			e.Add(tc.method, tc.path, func(c echo.Context) error {
+				panic("oh no")
+			})
+
+			req := httptest.NewRequest(tc.method, tc.path, nil)
+			rec := httptest.NewRecorder()
+
+			func() {
+				defer func() { recover() }()
+				e.ServeHTTP(rec, req)
+			}()
+
+			json, err := simplejson.NewJson(<-reports)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			event := json.Get("events").GetIndex(0)
+			if got, exp := event.GetPath("severityReason", "type").MustString(), "unhandledError"; got != exp {
+				t.Errorf("expected severityReason.type to be '%s' but was '%s'", exp, got)
+			}
+			if got, exp := event.Get("context").MustString(), tc.path; got != exp {
+				t.Errorf("expected context to be '%s' but was '%s'", exp, got)
+			}
+			if got, exp := event.GetPath("request", "httpMethod").MustString(), tc.method; got != exp {
+				t.Errorf("expected request.httpMethod to be '%s' but was '%s'", exp, got)
+			}
+
+			// stacktrace[0] is runtime.gopanic; the handler that panicked comes next.
+			frame := event.Get("exceptions").GetIndex(0).Get("stacktrace").GetIndex(1)
+			if inProject := frame.Get("inProject").MustBool(); !inProject {
+				t.Errorf("expected the panicking handler's stack frame to be marked inProject")
+			}
+
+			assertValidSession(t, event)
+		})
+	}
+}
+
+// assertValidSession checks that AutoNotify started a session on the
+// request context and reported the panic against it, mirroring
+// bugsnag_test.go's assertValidSession.
+func assertValidSession(t *testing.T, event *simplejson.Json) {
+	if sessionID := event.GetPath("session", "id").MustString(); len(sessionID) != 36 {
+		t.Errorf("expected a valid session ID to be set but was '%s'", sessionID)
+	}
+	if _, err := time.Parse(time.RFC3339, event.GetPath("session", "startedAt").MustString()); err != nil {
+		t.Error(err)
+	}
+	if got := event.GetPath("session", "events", "unhandled").MustInt(); got != 1 {
+		t.Errorf("expected 1 unhandled event in session but was %d", got)
+	}
+	if got := event.GetPath("session", "events", "handled").MustInt(); got != 0 {
+		t.Errorf("expected 0 handled events in session but was %d", got)
+	}
+}
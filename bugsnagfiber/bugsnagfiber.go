@@ -0,0 +1,66 @@
+package bugsnagfiber
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/bugsnag/bugsnag-go"
+	"github.com/gofiber/fiber/v2"
+)
+
+const FrameworkName string = "Fiber"
+
+// AutoNotify sends any panics to bugsnag, and then re-raises them.
+// You should use this after fiber's own recover.New() middleware so that
+// Bugsnag sees the panic before Fiber's own recovery does.
+// The arguments can be any RawData to pass to Bugsnag, most usually
+// you'll pass a bugsnag.Configuration object.
+func AutoNotify(rawData ...interface{}) fiber.Handler {
+	// Configure bugsnag with the passed in configuration (for manual notifications)
+	for _, datum := range rawData {
+		if c, ok := datum.(bugsnag.Configuration); ok {
+			bugsnag.Configure(c)
+		}
+	}
+
+	state := bugsnag.HandledState{
+		SeverityReason:   bugsnag.SeverityReasonUnhandledMiddlewareError,
+		OriginalSeverity: bugsnag.SeverityError,
+		Unhandled:        true,
+		Framework:        FrameworkName,
+	}
+	rawData = append(rawData, state)
+	return func(c *fiber.Ctx) error {
+		r, routeParams := requestFromCtx(c)
+		ctx := bugsnag.StartSession(r.Context())
+		ctx = bugsnag.AttachRequestData(ctx, r)
+		c.SetUserContext(ctx)
+
+		// create a notifier that has the current request bound to it
+		notifierData := append(rawData, r)
+		if len(routeParams) > 0 {
+			notifierData = append(notifierData, bugsnag.MetaData{"request": {"routeParams": routeParams}})
+		}
+		notifier := bugsnag.New(notifierData...)
+		notifier.FlushSessionsOnRepanic(false)
+		defer notifier.AutoNotify(ctx, r)
+		return c.Next()
+	}
+}
+
+// requestFromCtx translates a *fiber.Ctx, which has no net/http.Request of
+// its own, into the shape bugsnag.AttachRequestData expects: method, URL,
+// headers, and remote IP. Route params are returned separately rather than
+// folded into the URL's query string, since a route param can share a name
+// with a real query param (e.g. "/items/:foo?foo=real") and merging them
+// would silently discard one.
+func requestFromCtx(c *fiber.Ctx) (*http.Request, map[string]string) {
+	r, err := http.NewRequest(c.Method(), c.OriginalURL(), nil)
+	if err != nil {
+		r = &http.Request{Method: c.Method(), URL: &url.URL{Path: c.Path()}}
+	}
+	r.RemoteAddr = c.IP()
+	r.Header = http.Header(c.GetReqHeaders())
+
+	return r, c.AllParams()
+}
@@ -0,0 +1,145 @@
+package bugsnagfiber
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/bitly/go-simplejson"
+	"github.com/bugsnag/bugsnag-go"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/recover"
+)
+
+var testAPIKey = "166f5ad3590596f9aa8d601ea89af845"
+
+// setup starts a test event server for receiving the payload published by
+// AutoNotify, mirroring bugsnag_test.go's setup helper.
+func setup() (*httptest.Server, chan []byte) {
+	reports := make(chan []byte, 10)
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		reports <- body
+	})), reports
+}
+
+func generateSampleConfig(endpoint string) bugsnag.Configuration {
+	return bugsnag.Configuration{
+		APIKey:          testAPIKey,
+		Endpoints:       bugsnag.Endpoints{Notify: endpoint},
+		ProjectPackages: []string{"github.com/bugsnag/bugsnag-go/bugsnagfiber"},
+		ReleaseStage:    "test",
+	}
+}
+
+func TestAutoNotify(t *testing.T) {
+	tt := []struct {
+		name   string
+		path   string
+		method string
+	}{
+		{name: "panic on GET", path: "/ok", method: http.MethodGet},
+		{name: "panic on POST", path: "/submit", method: http.MethodPost},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ts, reports := setup()
+			defer ts.Close()
+
+			app := fiber.New()
+			app.Use(recover.New())
+			app.Use(AutoNotify(generateSampleConfig(ts.URL)))
+			app.Add(tc.method, tc.path, func(c *fiber.Ctx) error {
+				panic("oh no")
+			})
+
+			req := httptest.NewRequest(tc.method, tc.path, nil)
+			// The panic raised above and re-raised by AutoNotify is caught
+			// by the recover.New() middleware registered before it, so it
+			// does not crash the test process.
+			app.Test(req, -1)
+
+			json, err := simplejson.NewJson(<-reports)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			event := json.Get("events").GetIndex(0)
+			if got, exp := event.GetPath("severityReason", "type").MustString(), "unhandledError"; got != exp {
+				t.Errorf("expected severityReason.type to be '%s' but was '%s'", exp, got)
+			}
+			if got, exp := event.Get("context").MustString(), tc.path; got != exp {
+				t.Errorf("expected context to be '%s' but was '%s'", exp, got)
+			}
+			if got, exp := event.GetPath("request", "httpMethod").MustString(), tc.method; got != exp {
+				t.Errorf("expected request.httpMethod to be '%s' but was '%s'", exp, got)
+			}
+
+			// stacktrace[0] is runtime.gopanic; the handler that panicked comes next.
+			frame := event.Get("exceptions").GetIndex(0).Get("stacktrace").GetIndex(1)
+			if inProject := frame.Get("inProject").MustBool(); !inProject {
+				t.Errorf("expected the panicking handler's stack frame to be marked inProject")
+			}
+
+			assertValidSession(t, event)
+		})
+	}
+}
+
+// TestAutoNotifyRequestData checks that requestFromCtx's translation of a
+// *fiber.Ctx makes it all the way into the payload, modeled on TestHandler's
+// assertions against request.headers.* and metaData.request.params.*. It
+// also covers the case where a route param shares a name with a real query
+// param, guarding against the two being merged and one clobbering the other.
+func TestAutoNotifyRequestData(t *testing.T) {
+	ts, reports := setup()
+	defer ts.Close()
+
+	app := fiber.New()
+	app.Use(recover.New())
+	app.Use(AutoNotify(generateSampleConfig(ts.URL)))
+	app.Get("/items/:foo", func(c *fiber.Ctx) error {
+		panic("oh no")
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/items/route-value?foo=query-value", nil)
+	req.Header.Set("X-Test-Header", "header-value")
+	app.Test(req, -1)
+
+	json, err := simplejson.NewJson(<-reports)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	event := json.Get("events").GetIndex(0)
+	if got, exp := event.GetPath("request", "headers", "X-Test-Header").GetIndex(0).MustString(), "header-value"; got != exp {
+		t.Errorf("expected request.headers.X-Test-Header to be '%s' but was '%s'", exp, got)
+	}
+	if got, exp := event.GetPath("metaData", "request", "routeParams", "foo").MustString(), "route-value"; got != exp {
+		t.Errorf("expected metaData.request.routeParams.foo to be '%s' but was '%s'", exp, got)
+	}
+	if got, exp := event.GetPath("metaData", "request", "params", "foo").GetIndex(0).MustString(), "query-value"; got != exp {
+		t.Errorf("expected metaData.request.params.foo to be '%s' but was '%s'", exp, got)
+	}
+}
+
+// assertValidSession checks that AutoNotify started a session on the
+// request context and reported the panic against it, mirroring
+// bugsnag_test.go's assertValidSession.
+func assertValidSession(t *testing.T, event *simplejson.Json) {
+	if sessionID := event.GetPath("session", "id").MustString(); len(sessionID) != 36 {
+		t.Errorf("expected a valid session ID to be set but was '%s'", sessionID)
+	}
+	if _, err := time.Parse(time.RFC3339, event.GetPath("session", "startedAt").MustString()); err != nil {
+		t.Error(err)
+	}
+	if got := event.GetPath("session", "events", "unhandled").MustInt(); got != 1 {
+		t.Errorf("expected 1 unhandled event in session but was %d", got)
+	}
+	if got := event.GetPath("session", "events", "handled").MustInt(); got != 0 {
+		t.Errorf("expected 0 handled events in session but was %d", got)
+	}
+}
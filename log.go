@@ -0,0 +1,122 @@
+package bugsnag
+
+import (
+	"fmt"
+	"io/ioutil"
+	"log"
+	"sort"
+)
+
+// Logger extends the historical Printf-only logger contract with log
+// levels and a With method for attaching structured fields, so callers can
+// plug in zap, zerolog, slog, or anything else that can be adapted to it.
+// Routing the notifier's own diagnostics (transport errors, dropped
+// events, panics during delivery, "session not notified" warnings) through
+// it is a follow-up: that means touching the publisher and sessionTracker
+// call sites that reference Configuration.Logger today, not this file.
+type Logger interface {
+	Debugf(format string, v ...interface{})
+	Infof(format string, v ...interface{})
+	Warnf(format string, v ...interface{})
+	Errorf(format string, v ...interface{})
+
+	// With returns a Logger that annotates every subsequent call with the
+	// given fields, in addition to any fields already attached.
+	With(fields map[string]interface{}) Logger
+}
+
+// Stable field keys used by internal log calls, so structured log
+// backends can filter and correlate on them regardless of the message
+// text.
+const (
+	FieldEventID      = "event_id"
+	FieldAPIKeyPrefix = "api_key_prefix"
+	FieldEndpoint     = "endpoint"
+	FieldAttempt      = "attempt"
+	FieldStatusCode   = "status_code"
+	FieldReleaseStage = "release_stage"
+	FieldFramework    = "framework"
+)
+
+// printfLogger is the original logger contract accepted by
+// Configuration.Logger. *log.Logger satisfies it, as do simple stubs used
+// in tests.
+type printfLogger interface {
+	Printf(format string, v ...interface{})
+}
+
+// legacyLogger adapts a printfLogger to the Logger interface, flattening
+// level and fields into the formatted message. This keeps existing
+// log.Logger-based configurations working unchanged.
+type legacyLogger struct {
+	out    printfLogger
+	fields map[string]interface{}
+}
+
+func (l *legacyLogger) Debugf(format string, v ...interface{}) { l.logf("DEBUG", format, v...) }
+func (l *legacyLogger) Infof(format string, v ...interface{})  { l.logf("INFO", format, v...) }
+func (l *legacyLogger) Warnf(format string, v ...interface{})  { l.logf("WARN", format, v...) }
+func (l *legacyLogger) Errorf(format string, v ...interface{}) { l.logf("ERROR", format, v...) }
+
+func (l *legacyLogger) With(fields map[string]interface{}) Logger {
+	merged := make(map[string]interface{}, len(l.fields)+len(fields))
+	for k, v := range l.fields {
+		merged[k] = v
+	}
+	for k, v := range fields {
+		merged[k] = v
+	}
+	return &legacyLogger{out: l.out, fields: merged}
+}
+
+func (l *legacyLogger) logf(level, format string, v ...interface{}) {
+	msg := fmt.Sprintf(format, v...)
+	if suffix := formatFields(l.fields); suffix != "" {
+		msg = msg + " " + suffix
+	}
+	l.out.Printf("%s %s", level, msg)
+}
+
+// formatFields renders fields as sorted key=value pairs so log output is
+// deterministic and easy to grep.
+func formatFields(fields map[string]interface{}) string {
+	if len(fields) == 0 {
+		return ""
+	}
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var out string
+	for i, k := range keys {
+		if i > 0 {
+			out += " "
+		}
+		out += fmt.Sprintf("%s=%v", k, fields[k])
+	}
+	return out
+}
+
+// discardLogger is used when Configuration.Logger is nil, mirroring the
+// behaviour of the previous Printf-only default.
+var discardLogger Logger = &legacyLogger{out: log.New(ioutil.Discard, "", 0)}
+
+// newLogger adapts whatever is set on Configuration.Logger to the Logger
+// interface, so the rest of the notifier can log through the richer
+// interface regardless of what a caller configured: a value that already
+// implements Logger is used as-is, a plain printfLogger (such as
+// *log.Logger) is wrapped, and nil falls back to discarding output.
+func newLogger(l interface{}) Logger {
+	switch v := l.(type) {
+	case nil:
+		return discardLogger
+	case Logger:
+		return v
+	case printfLogger:
+		return &legacyLogger{out: v}
+	default:
+		return discardLogger
+	}
+}
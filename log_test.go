@@ -0,0 +1,93 @@
+package bugsnag
+
+import "testing"
+
+type fakePrintfLogger struct {
+	format string
+	args   []interface{}
+}
+
+func (f *fakePrintfLogger) Printf(format string, v ...interface{}) {
+	f.format = format
+	f.args = v
+}
+
+func TestNewLoggerNil(t *testing.T) {
+	if got := newLogger(nil); got != discardLogger {
+		t.Errorf("expected newLogger(nil) to return discardLogger, got %v", got)
+	}
+}
+
+func TestNewLoggerWrapsPrintfLogger(t *testing.T) {
+	out := &fakePrintfLogger{}
+	l := newLogger(out)
+
+	l.Errorf("boom %d", 42)
+
+	if out.format == "" {
+		t.Fatal("expected the wrapped printfLogger to receive a call")
+	}
+	if len(out.args) != 2 || out.args[0] != "ERROR" {
+		t.Errorf("expected level ERROR and the formatted message, got %v", out.args)
+	}
+}
+
+func TestNewLoggerPassesThroughLogger(t *testing.T) {
+	out := &fakePrintfLogger{}
+	l := &legacyLogger{out: out}
+
+	if got := newLogger(l); got != Logger(l) {
+		t.Errorf("expected a Logger to be used as-is, got %v", got)
+	}
+}
+
+func TestNewLoggerUnsupportedType(t *testing.T) {
+	if got := newLogger(42); got != discardLogger {
+		t.Errorf("expected an unsupported type to fall back to discardLogger, got %v", got)
+	}
+}
+
+func TestLegacyLoggerLevelsAndFields(t *testing.T) {
+	out := &fakePrintfLogger{}
+	l := &legacyLogger{out: out}
+
+	l.With(map[string]interface{}{"b": 2, "a": 1}).Infof("hello %s", "world")
+
+	if out.format != "%s %s" {
+		t.Fatalf("expected legacyLogger to format through Printf, got format %q", out.format)
+	}
+	if len(out.args) != 2 {
+		t.Fatalf("expected level and message args, got %v", out.args)
+	}
+	if out.args[0] != "INFO" {
+		t.Errorf("expected level INFO, got %v", out.args[0])
+	}
+	if got, want := out.args[1], "hello world a=1 b=2"; got != want {
+		t.Errorf("expected message %q, got %q", want, got)
+	}
+}
+
+func TestLegacyLoggerWithMergesFields(t *testing.T) {
+	out := &fakePrintfLogger{}
+	base := (&legacyLogger{out: out}).With(map[string]interface{}{"a": 1})
+	child := base.With(map[string]interface{}{"b": 2})
+
+	child.Debugf("msg")
+
+	if got, want := out.args[1], "msg a=1 b=2"; got != want {
+		t.Errorf("expected fields from both With calls to be merged, got %q want %q", got, want)
+	}
+}
+
+func TestFormatFieldsEmpty(t *testing.T) {
+	if got := formatFields(nil); got != "" {
+		t.Errorf("expected empty fields to format as \"\", got %q", got)
+	}
+}
+
+func TestFormatFieldsSortedByKey(t *testing.T) {
+	got := formatFields(map[string]interface{}{"z": 1, "a": 2, "m": 3})
+	if want := "a=2 m=3 z=1"; got != want {
+		t.Errorf("expected fields sorted by key, got %q want %q", got, want)
+	}
+}
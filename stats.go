@@ -0,0 +1,122 @@
+package bugsnag
+
+import (
+	"expvar"
+	"sync/atomic"
+	"time"
+)
+
+// Stats is a point-in-time snapshot of the notifier's delivery and session
+// pipeline, useful for wiring into operational dashboards without reaching
+// into internal globals like sessionTracker.
+//
+// The counters are populated only by noteEventQueued, noteEventDelivered,
+// noteEventDropped, noteSessionTracked, and noteSessionFlushed. The async
+// publisher and the sessionTracker do not call these hooks, so unless
+// something else in this process calls them, Stats reads all zero: callers
+// building a health check on top of it should treat an all-zero Stats as
+// "nothing observed yet", not as "healthy".
+type Stats struct {
+	EventsQueued      int64     `json:"events_queued"`
+	EventsDelivered   int64     `json:"events_delivered"`
+	EventsDropped     int64     `json:"events_dropped"`
+	LastDeliveryError string    `json:"last_delivery_error,omitempty"`
+	LastDeliveryAt    time.Time `json:"last_delivery_at,omitempty"`
+
+	SessionsTracked    int64 `json:"sessions_tracked"`
+	SessionsFlushed    int64 `json:"sessions_flushed"`
+	SessionFlushErrors int64 `json:"session_flush_errors"`
+}
+
+// expvar counters are kept alongside the atomic counters below so that
+// anything scraping expvar (including Prometheus expvar bridges) gets the
+// same numbers without any extra wiring.
+const expvarPrefix = "bugsnag."
+
+var (
+	statEventsQueued       int64
+	statEventsDelivered    int64
+	statEventsDropped      int64
+	statSessionsTracked    int64
+	statSessionsFlushed    int64
+	statSessionFlushErrors int64
+
+	statLastDeliveryError atomic.Value // string
+	statLastDeliveryAt    atomic.Value // time.Time
+
+	expvarEventsQueued       = expvar.NewInt(expvarPrefix + "events_queued")
+	expvarEventsDelivered    = expvar.NewInt(expvarPrefix + "events_delivered")
+	expvarEventsDropped      = expvar.NewInt(expvarPrefix + "events_dropped")
+	expvarSessionsTracked    = expvar.NewInt(expvarPrefix + "sessions_tracked")
+	expvarSessionsFlushed    = expvar.NewInt(expvarPrefix + "sessions_flushed")
+	expvarSessionFlushErrors = expvar.NewInt(expvarPrefix + "session_flush_errors")
+)
+
+func init() {
+	statLastDeliveryError.Store("")
+	statLastDeliveryAt.Store(time.Time{})
+}
+
+// noteEventQueued records that an event was handed to the async publisher.
+func noteEventQueued() {
+	atomic.AddInt64(&statEventsQueued, 1)
+	expvarEventsQueued.Add(1)
+}
+
+// noteEventDelivered records a successful delivery to the Bugsnag API.
+func noteEventDelivered() {
+	atomic.AddInt64(&statEventsDelivered, 1)
+	expvarEventsDelivered.Add(1)
+	statLastDeliveryError.Store("")
+	statLastDeliveryAt.Store(time.Now())
+}
+
+// noteEventDropped records an event that was discarded, either because the
+// publisher's channel was full or because delivery ultimately failed with
+// Synchronous set to false. err may be nil for a full-channel drop.
+func noteEventDropped(err error) {
+	atomic.AddInt64(&statEventsDropped, 1)
+	expvarEventsDropped.Add(1)
+	if err != nil {
+		statLastDeliveryError.Store(err.Error())
+		statLastDeliveryAt.Store(time.Now())
+	}
+}
+
+// noteSessionTracked records that the sessionTracker started a new session.
+func noteSessionTracked() {
+	atomic.AddInt64(&statSessionsTracked, 1)
+	expvarSessionsTracked.Add(1)
+}
+
+// noteSessionFlushed records a flush attempt of tracked sessions to the
+// sessions endpoint: a successful one counts towards SessionsFlushed, and
+// one with failed set to true counts towards SessionFlushErrors instead.
+func noteSessionFlushed(failed bool) {
+	if failed {
+		atomic.AddInt64(&statSessionFlushErrors, 1)
+		expvarSessionFlushErrors.Add(1)
+		return
+	}
+	atomic.AddInt64(&statSessionsFlushed, 1)
+	expvarSessionsFlushed.Add(1)
+}
+
+// Stats returns a snapshot of the current notifier health counters. It is
+// safe to call from any goroutine.
+func Stats() Stats {
+	lastErr, _ := statLastDeliveryError.Load().(string)
+	lastAt, _ := statLastDeliveryAt.Load().(time.Time)
+
+	return Stats{
+		EventsQueued:      atomic.LoadInt64(&statEventsQueued),
+		EventsDelivered:   atomic.LoadInt64(&statEventsDelivered),
+		EventsDropped:     atomic.LoadInt64(&statEventsDropped),
+		LastDeliveryError: lastErr,
+		LastDeliveryAt:    lastAt,
+
+		SessionsTracked:    atomic.LoadInt64(&statSessionsTracked),
+		SessionsFlushed:    atomic.LoadInt64(&statSessionsFlushed),
+		SessionFlushErrors: atomic.LoadInt64(&statSessionFlushErrors),
+	}
+}
@@ -0,0 +1,83 @@
+package bugsnag
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNoteEventQueuedAndDelivered(t *testing.T) {
+	before := Stats()
+
+	noteEventQueued()
+	noteEventDelivered()
+
+	after := Stats()
+	if after.EventsQueued != before.EventsQueued+1 {
+		t.Errorf("expected EventsQueued to increment by 1, went from %d to %d", before.EventsQueued, after.EventsQueued)
+	}
+	if after.EventsDelivered != before.EventsDelivered+1 {
+		t.Errorf("expected EventsDelivered to increment by 1, went from %d to %d", before.EventsDelivered, after.EventsDelivered)
+	}
+	if after.LastDeliveryError != "" {
+		t.Errorf("expected LastDeliveryError to be cleared by a successful delivery, got %q", after.LastDeliveryError)
+	}
+	if after.LastDeliveryAt.IsZero() {
+		t.Error("expected LastDeliveryAt to be set after a successful delivery")
+	}
+}
+
+func TestNoteEventDropped(t *testing.T) {
+	before := Stats()
+
+	noteEventDropped(errors.New("connection refused"))
+
+	after := Stats()
+	if after.EventsDropped != before.EventsDropped+1 {
+		t.Errorf("expected EventsDropped to increment by 1, went from %d to %d", before.EventsDropped, after.EventsDropped)
+	}
+	if after.LastDeliveryError != "connection refused" {
+		t.Errorf("expected LastDeliveryError to be set, got %q", after.LastDeliveryError)
+	}
+}
+
+func TestNoteEventDroppedNilErrDoesNotTouchLastDeliveryError(t *testing.T) {
+	noteEventDelivered()
+	before := Stats()
+
+	noteEventDropped(nil)
+
+	after := Stats()
+	if after.LastDeliveryError != before.LastDeliveryError {
+		t.Errorf("expected a nil-error drop to leave LastDeliveryError alone, got %q", after.LastDeliveryError)
+	}
+}
+
+func TestNoteSessionTrackedAndFlushed(t *testing.T) {
+	before := Stats()
+
+	noteSessionTracked()
+	noteSessionFlushed(false)
+
+	after := Stats()
+	if after.SessionsTracked != before.SessionsTracked+1 {
+		t.Errorf("expected SessionsTracked to increment by 1, went from %d to %d", before.SessionsTracked, after.SessionsTracked)
+	}
+	if after.SessionsFlushed != before.SessionsFlushed+1 {
+		t.Errorf("expected SessionsFlushed to increment by 1, went from %d to %d", before.SessionsFlushed, after.SessionsFlushed)
+	}
+}
+
+func TestNoteSessionFlushedFailure(t *testing.T) {
+	before := Stats()
+
+	noteSessionTracked()
+	noteSessionFlushed(true)
+
+	after := Stats()
+	if after.SessionFlushErrors != before.SessionFlushErrors+1 {
+		t.Errorf("expected SessionFlushErrors to increment by 1, went from %d to %d", before.SessionFlushErrors, after.SessionFlushErrors)
+	}
+	if after.SessionsFlushed != before.SessionsFlushed {
+		t.Errorf("expected a failed flush not to increment SessionsFlushed")
+	}
+}